@@ -0,0 +1,194 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ModuleSource selects how a Workspace's Terraform configuration is supplied to Terraform Cloud.
+type ModuleSource string
+
+const (
+	// ModuleSourceRemote treats Module as a go-getter source address: a VCS repository, an
+	// S3/GCS bucket, or any other go-getter source. Module registry addresses are not resolved.
+	ModuleSourceRemote ModuleSource = "Remote"
+	// ModuleSourceInline packages Module as the literal contents of a main.tf and uploads it to
+	// Terraform Cloud as a new configuration version, with no external VCS repository required.
+	ModuleSourceInline ModuleSource = "Inline"
+)
+
+// VariableCategory selects whether a Variable is a Terraform variable or a shell environment
+// variable in Terraform Cloud.
+type VariableCategory string
+
+const (
+	// CategoryTerraform is a Terraform input variable.
+	CategoryTerraform VariableCategory = "terraform"
+	// CategoryEnv is a shell environment variable exposed to the Terraform run.
+	CategoryEnv VariableCategory = "env"
+)
+
+// Variable is a Terraform Cloud variable on a Workspace.
+type Variable struct {
+	// Key is the variable name.
+	Key string `json:"key"`
+	// Value is the variable value. TFC never returns a sensitive value back, so for sensitive
+	// variables drift detection compares a hash of Value with the hash recorded when it was last
+	// pushed, and Value is re-pushed whenever that hash changes.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Category selects whether this is a Terraform variable or an environment variable. Defaults
+	// to terraform.
+	// +kubebuilder:validation:Enum=terraform;env
+	// +optional
+	Category VariableCategory `json:"category,omitempty"`
+	// HCL marks Value as HCL to be parsed by Terraform rather than a literal string.
+	// +optional
+	HCL bool `json:"hcl,omitempty"`
+	// Sensitive marks the variable as write-only in Terraform Cloud.
+	// +optional
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// RunOptions customizes the runs triggered for a Workspace with resource targeting, forced
+// replacement, and refresh-only plans.
+type RunOptions struct {
+	// Target lists resource addresses to target with -target. Requires the workspace's
+	// Terraform Cloud organization to be on remote API version 2.3 or newer.
+	// +optional
+	Target []string `json:"target,omitempty"`
+	// Replace lists resource addresses to force replacement of with -replace. Requires the
+	// workspace's Terraform Cloud organization to be on remote API version 2.3 or newer.
+	// +optional
+	Replace []string `json:"replace,omitempty"`
+	// RefreshOnly requests a refresh-only plan, which updates state without proposing changes.
+	// +optional
+	RefreshOnly bool `json:"refreshOnly,omitempty"`
+	// Message is attached to the run as its changelog message.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TokenSourceType selects where the operator reads its Terraform Cloud API token from.
+type TokenSourceType string
+
+const (
+	// TokenSourceCLIConfig reads the token from the local Terraform CLI config (~/.terraformrc),
+	// matching Terraform's own credentials helper. This is the default.
+	TokenSourceCLIConfig TokenSourceType = "CLIConfig"
+	// TokenSourceEnv reads the token from the TFE_TOKEN or TFC_TOKEN environment variable.
+	TokenSourceEnv TokenSourceType = "Env"
+	// TokenSourceSecret reads the token from a key in the Kubernetes Secret named by
+	// TokenSecretRef.
+	TokenSourceSecret TokenSourceType = "Secret"
+)
+
+// SecretKeyRef references a key within a Kubernetes Secret.
+type SecretKeyRef struct {
+	// Name is the Secret's name.
+	Name string `json:"name"`
+	// Namespace is the Secret's namespace. Defaults to the Workspace's own namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Key is the key within the Secret's data holding the token. Defaults to "token".
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// WorkspaceParameters are the Terraform Cloud settings for a Workspace.
+type WorkspaceParameters struct {
+	// Organization is the Terraform Cloud organization that owns the workspace.
+	Organization string `json:"organization"`
+
+	// Source selects how Module is interpreted. Defaults to Remote.
+	// +kubebuilder:validation:Enum=Remote;Inline
+	// +optional
+	Source ModuleSource `json:"source,omitempty"`
+
+	// Module is a go-getter source address when Source is Remote, or the literal
+	// contents of a main.tf when Source is Inline.
+	// +optional
+	Module string `json:"module,omitempty"`
+
+	// Variables are the Terraform Cloud variables to reconcile onto the workspace.
+	// +optional
+	Variables []Variable `json:"variables,omitempty"`
+
+	// RunOptions customizes the runs triggered for this workspace.
+	// +optional
+	RunOptions *RunOptions `json:"runOptions,omitempty"`
+
+	// Hostname is the Terraform Cloud or Terraform Enterprise hostname to talk to. Defaults to
+	// app.terraform.io.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// TokenSource selects where the operator reads its Terraform Cloud API token from. Defaults
+	// to CLIConfig.
+	// +kubebuilder:validation:Enum=CLIConfig;Env;Secret
+	// +optional
+	TokenSource TokenSourceType `json:"tokenSource,omitempty"`
+
+	// TokenSecretRef references the Kubernetes Secret holding the API token when TokenSource is
+	// Secret.
+	// +optional
+	TokenSecretRef *SecretKeyRef `json:"tokenSecretRef,omitempty"`
+
+	// CostEstimateEnabled makes the operator read each run's cost estimate, record it on the
+	// status, and disable auto-apply so the estimate can gate the apply. It does not turn cost
+	// estimation on in Terraform Cloud; a run without an estimate is not gated. When
+	// MaxMonthlyCostIncrease is also set, the apply is automatically canceled if the estimate
+	// exceeds it.
+	// +optional
+	CostEstimateEnabled bool `json:"costEstimateEnabled,omitempty"`
+
+	// MaxMonthlyCostIncrease is the highest monthly cost increase, in the workspace's currency,
+	// a run may project before its apply is automatically canceled. Only enforced when
+	// CostEstimateEnabled is true.
+	// +optional
+	MaxMonthlyCostIncrease string `json:"maxMonthlyCostIncrease,omitempty"`
+
+	// PolicyCheckOverride lets the operator override soft-mandatory Sentinel/OPA policy check
+	// failures and proceed with the apply.
+	// +optional
+	PolicyCheckOverride bool `json:"policyCheckOverride,omitempty"`
+}
+
+// WorkspaceStatus is the observed state of a Workspace.
+type WorkspaceStatus struct {
+	// WorkspaceID is the Terraform Cloud workspace ID backing this resource.
+	// +optional
+	WorkspaceID string `json:"workspaceID,omitempty"`
+	// ObservedGeneration is the Workspace's Generation the last time its module was uploaded and
+	// a run was triggered, so unrelated status-only updates don't retrigger a run.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// RunID is the most recent Terraform Cloud run triggered for this workspace.
+	// +optional
+	RunID string `json:"runID,omitempty"`
+	// RunStatus is RunID's current Terraform Cloud status (e.g. planning, cost_estimated,
+	// policy_checked, applying, applied).
+	// +optional
+	RunStatus string `json:"runStatus,omitempty"`
+	// CostEstimate summarizes RunID's most recent cost estimate, when CostEstimateEnabled is set.
+	// +optional
+	CostEstimate string `json:"costEstimate,omitempty"`
+	// PolicyCheckStatus is the outcome of RunID's most recent Sentinel/OPA policy check.
+	// +optional
+	PolicyCheckStatus string `json:"policyCheckStatus,omitempty"`
+}
+
+// Workspace is the Schema for the workspaces API.
+type Workspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkspaceParameters `json:"spec,omitempty"`
+	Status WorkspaceStatus     `json:"status,omitempty"`
+}
+
+// WorkspaceList contains a list of Workspace.
+type WorkspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workspace `json:"items"`
+}
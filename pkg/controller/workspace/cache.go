@@ -0,0 +1,79 @@
+package workspace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+// cacheTTL is how long a cached workspace and its variables are considered fresh before
+// CheckVariables re-reads them from Terraform Cloud.
+const cacheTTL = 30 * time.Second
+
+type cacheEntry struct {
+	workspace *tfc.Workspace
+	variables []*tfc.Variable
+	expiresAt time.Time
+}
+
+// workspaceCache caches per-workspace Terraform Cloud state across reconciles, so workspaces with
+// hundreds of variables don't re-read the full workspace and variable list on every reconcile.
+type workspaceCache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// globalWorkspaceCache is shared by every TerraformCloudClient, keyed by organization/workspace.
+var globalWorkspaceCache = &workspaceCache{entries: map[string]*cacheEntry{}}
+
+func (c *workspaceCache) get(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry, true
+}
+
+func (c *workspaceCache) set(key string, workspace *tfc.Workspace, variables []*tfc.Variable) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = &cacheEntry{
+		workspace: workspace,
+		variables: variables,
+		expiresAt: time.Now().Add(cacheTTL),
+	}
+}
+
+func (c *workspaceCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// workspaceAndVariables returns workspace's TFC workspace and variable list, reading through
+// globalWorkspaceCache to avoid a pair of API calls on every reconcile.
+func (t *TerraformCloudClient) workspaceAndVariables(workspace string) (*tfc.Workspace, []*tfc.Variable, error) {
+	key := t.Organization + "/" + workspace
+	if entry, ok := globalWorkspaceCache.get(key); ok {
+		return entry.workspace, entry.variables, nil
+	}
+
+	tfcWorkspace, err := t.Client.Workspaces.Read(context.TODO(), t.Organization, workspace)
+	tfcAPICalls.WithLabelValues("Workspaces.Read").Inc()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variables, err := t.listVariables(workspace)
+	tfcAPICalls.WithLabelValues("Variables.List").Inc()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	globalWorkspaceCache.set(key, tfcWorkspace, variables)
+	return tfcWorkspace, variables, nil
+}
@@ -0,0 +1,64 @@
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func TestWorkspaceCacheGetMiss(t *testing.T) {
+	c := &workspaceCache{entries: map[string]*cacheEntry{}}
+
+	if _, ok := c.get("org/missing"); ok {
+		t.Fatalf("expected a miss for a key that was never set")
+	}
+}
+
+func TestWorkspaceCacheSetThenGet(t *testing.T) {
+	c := &workspaceCache{entries: map[string]*cacheEntry{}}
+	ws := &tfc.Workspace{ID: "ws-123"}
+	vars := []*tfc.Variable{{Key: "foo"}}
+
+	c.set("org/workspace", ws, vars)
+
+	gotWS, gotVars, ok := func() (*tfc.Workspace, []*tfc.Variable, bool) {
+		entry, ok := c.get("org/workspace")
+		if !ok {
+			return nil, nil, false
+		}
+		return entry.workspace, entry.variables, true
+	}()
+	if !ok {
+		t.Fatalf("expected a hit after set")
+	}
+	if gotWS != ws {
+		t.Fatalf("expected the cached workspace to be returned")
+	}
+	if len(gotVars) != 1 || gotVars[0].Key != "foo" {
+		t.Fatalf("expected the cached variables to be returned, got %v", gotVars)
+	}
+}
+
+func TestWorkspaceCacheExpiresAfterTTL(t *testing.T) {
+	c := &workspaceCache{entries: map[string]*cacheEntry{}}
+	c.entries["org/workspace"] = &cacheEntry{
+		workspace: &tfc.Workspace{ID: "ws-123"},
+		expiresAt: time.Now().Add(-time.Second),
+	}
+
+	if _, ok := c.get("org/workspace"); ok {
+		t.Fatalf("expected an expired entry to be treated as a miss")
+	}
+}
+
+func TestWorkspaceCacheInvalidate(t *testing.T) {
+	c := &workspaceCache{entries: map[string]*cacheEntry{}}
+	c.set("org/workspace", &tfc.Workspace{ID: "ws-123"}, nil)
+
+	c.invalidate("org/workspace")
+
+	if _, ok := c.get("org/workspace"); ok {
+		t.Fatalf("expected the entry to be gone after invalidate")
+	}
+}
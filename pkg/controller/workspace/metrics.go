@@ -0,0 +1,33 @@
+package workspace
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// tfcAPICalls counts the Terraform Cloud API calls made to read a workspace and to list,
+	// create, update, and delete its variables, by method. Other API calls are not counted.
+	tfcAPICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_k8s_tfc_api_calls_total",
+		Help: "Total Terraform Cloud API calls made to read workspaces and manage their variables, by method.",
+	}, []string{"method"})
+
+	// tfcAPIRetries counts retries the Terraform Cloud HTTP client performed due to rate
+	// limiting or server errors.
+	tfcAPIRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_k8s_tfc_api_retries_total",
+		Help: "Total retries performed against the Terraform Cloud API.",
+	}, []string{"method"})
+
+	// reconcileDuration observes how long a Workspace reconcile took, by workspace name.
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "terraform_k8s_reconcile_duration_seconds",
+		Help:    "Duration of a Workspace reconcile, by workspace name.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"workspace"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(tfcAPICalls, tfcAPIRetries, reconcileDuration)
+}
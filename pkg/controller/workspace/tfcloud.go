@@ -2,65 +2,246 @@ package workspace
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
 
+	getter "github.com/hashicorp/go-getter"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	tfc "github.com/hashicorp/go-tfe"
+	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-k8s/pkg/apis/app/v1alpha1"
-	"github.com/hashicorp/terraform/command/cliconfig"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	// PageSize is page size for TFC API
 	PageSize = 500
+	// mainConfigFile is the filename an inline module's HCL is written to before packaging.
+	mainConfigFile = "main.tf"
+	// sensitiveHashAnnotation is the Workspace CR annotation prefix, followed by the variable's
+	// category and key, used to track the last-applied hash of each sensitive variable's value,
+	// since Terraform Cloud never returns sensitive values back and drift can't otherwise be
+	// detected from the API.
+	sensitiveHashAnnotation = "workspace.terraform.io/variable-hash-"
+	// maxConcurrentVariableOps bounds how many variable creates/updates/deletes CheckVariables
+	// issues to Terraform Cloud at once.
+	maxConcurrentVariableOps = 10
 )
 
+// minTargetingAPIVersion is the lowest Terraform Cloud remote API version that accepts -target
+// and -replace run options, matching the gate Terraform's remote backend applies.
+var minTargetingAPIVersion = version.Must(version.NewVersion("2.3"))
+
+// runPollInterval is how often PollRun checks on a run while it progresses through its lifecycle.
+const runPollInterval = 5 * time.Second
+
+// terminalRunStatuses are the run statuses PollRun stops on. RunPolicySoftFailed is only terminal
+// when PolicyCheckOverride is unset.
+var terminalRunStatuses = map[tfc.RunStatus]bool{
+	tfc.RunApplied:            true,
+	tfc.RunCanceled:           true,
+	tfc.RunDiscarded:          true,
+	tfc.RunErrored:            true,
+	tfc.RunPlannedAndFinished: true,
+	tfc.RunPolicySoftFailed:   true,
+}
+
+// RunStages is the outcome of a run after PollRun has walked it through plan, cost estimate,
+// policy check, and apply.
+type RunStages struct {
+	// Status is the run's terminal Terraform Cloud status.
+	Status tfc.RunStatus
+	// CostEstimate summarizes the run's cost estimate, if CostEstimateEnabled was set.
+	CostEstimate string
+	// PolicyCheckStatus is the outcome of the run's Sentinel/OPA policy check, if any ran.
+	PolicyCheckStatus string
+}
+
+// PollRun polls run through its lifecycle -- plan, cost estimate, policy check, apply -- gating
+// each stage according to params, and returns once the run reaches a terminal status.
+func (t *TerraformCloudClient) PollRun(runID string, params v1alpha1.WorkspaceParameters) (*RunStages, error) {
+	stages := &RunStages{}
+	for {
+		run, err := t.Client.Runs.ReadWithOptions(context.TODO(), runID, &tfc.RunReadOptions{
+			Include: []tfc.RunIncludeOpt{tfc.RunCostEstimate, tfc.RunPolicyChecks},
+		})
+		if err != nil {
+			return stages, err
+		}
+		stages.Status = run.Status
+
+		if run.Status == tfc.RunCostEstimated && params.CostEstimateEnabled && run.CostEstimate != nil {
+			canceled, err := t.evaluateCostEstimate(run, params, stages)
+			if err != nil {
+				return stages, err
+			}
+			if canceled {
+				time.Sleep(runPollInterval)
+				continue
+			}
+		}
+
+		if run.Status == tfc.RunPolicyChecked || run.Status == tfc.RunPolicySoftFailed {
+			if err := t.evaluatePolicyChecks(run, params, stages); err != nil {
+				return stages, err
+			}
+		}
+
+		// With auto-apply disabled for gated workspaces (see autoApplyFor), Terraform Cloud leaves
+		// a run that has cleared its configured cost estimate and policy check gates waiting for a
+		// confirmation that never arrives. Apply it ourselves once it's confirmable, so compliant
+		// runs proceed instead of blocking the reconcile forever.
+		if !autoApplyFor(params) && run.Actions != nil && run.Actions.IsConfirmable {
+			if err := t.Client.Runs.Apply(context.TODO(), run.ID, tfc.RunApplyOptions{}); err != nil {
+				return stages, err
+			}
+		}
+
+		// A soft-failed policy check that the operator just overrode is not final: keep polling so
+		// the overridden run can be applied once it's confirmable.
+		overridden := run.Status == tfc.RunPolicySoftFailed && params.PolicyCheckOverride
+		if terminalRunStatuses[run.Status] && !overridden {
+			return stages, nil
+		}
+
+		time.Sleep(runPollInterval)
+	}
+}
+
+// evaluateCostEstimate records run's projected cost and cancels the apply if it exceeds
+// params.MaxMonthlyCostIncrease, reporting whether it canceled the run.
+func (t *TerraformCloudClient) evaluateCostEstimate(run *tfc.Run, params v1alpha1.WorkspaceParameters, stages *RunStages) (bool, error) {
+	estimate := run.CostEstimate
+	stages.CostEstimate = fmt.Sprintf("delta monthly cost: %s", estimate.DeltaMonthlyCost)
+
+	if params.MaxMonthlyCostIncrease == "" {
+		return false, nil
+	}
+	max, err := strconv.ParseFloat(params.MaxMonthlyCostIncrease, 64)
+	if err != nil {
+		return false, fmt.Errorf("parsing maxMonthlyCostIncrease %q: %v", params.MaxMonthlyCostIncrease, err)
+	}
+	delta, err := strconv.ParseFloat(estimate.DeltaMonthlyCost, 64)
+	if err != nil {
+		// Not every estimate reports a numeric delta (e.g. providers without cost data); treat
+		// it as within bounds rather than blocking the run.
+		return false, nil
+	}
+	if delta <= max {
+		return false, nil
+	}
+
+	comment := fmt.Sprintf("auto-canceled: projected monthly cost increase %.2f exceeds limit %.2f", delta, max)
+	if err := t.Client.Runs.Cancel(context.TODO(), run.ID, tfc.RunCancelOptions{Comment: &comment}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// evaluatePolicyChecks records run's policy check outcome and overrides soft-mandatory failures
+// when params.PolicyCheckOverride is set.
+func (t *TerraformCloudClient) evaluatePolicyChecks(run *tfc.Run, params v1alpha1.WorkspaceParameters, stages *RunStages) error {
+	for _, check := range run.PolicyChecks {
+		stages.PolicyCheckStatus = string(check.Status)
+		if check.Status == tfc.PolicySoftFailed && params.PolicyCheckOverride {
+			if _, err := t.Client.PolicyChecks.Override(context.TODO(), check.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 var (
 	// TerraformVariable is a variable
 	TerraformVariable = tfc.CategoryTerraform
 	// EnvironmentVariable is an environment variable
 	EnvironmentVariable = tfc.CategoryEnv
-	// Sensitive defaults to false
-	Sensitive = false
-	// AutoApply run to workspace
-	AutoApply = true
 )
 
 // TerraformCloudClient has a TFC Client and organization
 type TerraformCloudClient struct {
 	Client       *tfc.Client
 	Organization string
+	// Hostname is the Terraform Cloud or Terraform Enterprise hostname to talk to. Defaults to
+	// app.terraform.io.
+	Hostname string
+	// TokenSource resolves the API token to authenticate with. Defaults to CLIConfigTokenSource.
+	TokenSource TokenSource
 }
 
-// GetClient creates the configuration for Terraform Cloud
+// GetClient creates the configuration for Terraform Cloud, authenticating via t.TokenSource and
+// defaulting to the local Terraform CLI config when unset.
 func (t *TerraformCloudClient) GetClient() error {
-	tfConfig, diag := cliconfig.LoadConfig()
-	if diag.Err() != nil {
-		return diag.Err()
+	tokenSource := t.TokenSource
+	if tokenSource == nil {
+		tokenSource = CLIConfigTokenSource{Hostname: t.Hostname}
+	}
+	token, err := tokenSource.Token()
+	if err != nil {
+		return err
+	}
+
+	hostname := t.Hostname
+	if hostname == "" {
+		hostname = defaultHostname
 	}
 
 	config := &tfc.Config{
-		Token: fmt.Sprintf("%v", tfConfig.Credentials["app.terraform.io"]["token"]),
+		Address:    fmt.Sprintf("https://%s", hostname),
+		Token:      token,
+		HTTPClient: retryableHTTPClient(),
 	}
 
 	client, err := tfc.NewClient(config)
 	if err != nil {
-		return diag.Err()
+		return err
 	}
 	t.Client = client
 	return nil
 }
 
+// retryableHTTPClient returns an HTTP client that backs off and retries when Terraform Cloud
+// responds with a rate-limit or server error, recording each retry as a Prometheus metric.
+func retryableHTTPClient() *http.Client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.Logger = nil
+	retryClient.ResponseLogHook = func(_ retryablehttp.Logger, resp *http.Response) {
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			tfcAPIRetries.WithLabelValues(resp.Request.Method).Inc()
+		}
+	}
+	return retryClient.StandardClient()
+}
+
 // CheckOrganization looks for an organization
 func (t *TerraformCloudClient) CheckOrganization() error {
 	_, err := t.Client.Organizations.Read(context.TODO(), t.Organization)
 	return err
 }
 
-// CheckWorkspace looks for a workspace
-func (t *TerraformCloudClient) CheckWorkspace(workspace string) (string, error) {
+// autoApplyFor reports whether the workspace backing params may auto-apply. Cost-estimate and
+// policy-check gating only has a chance to act between plan and apply, so a workspace with either
+// enabled must not auto-apply, or Terraform Cloud can apply before the controller observes
+// (and, if needed, cancels) the run.
+func autoApplyFor(params v1alpha1.WorkspaceParameters) bool {
+	return !params.CostEstimateEnabled && !params.PolicyCheckOverride
+}
+
+// CheckWorkspace looks for a workspace, creating it with the given auto-apply setting if it
+// doesn't exist yet, and correcting the setting on an existing workspace if it has drifted.
+func (t *TerraformCloudClient) CheckWorkspace(workspace string, autoApply bool) (string, error) {
 	ws, err := t.Client.Workspaces.Read(context.TODO(), t.Organization, workspace)
 	if err != nil && err == tfc.ErrResourceNotFound {
-		id, err := t.CreateWorkspace(workspace)
+		id, err := t.CreateWorkspace(workspace, autoApply)
 		if err != nil {
 			return "", err
 		}
@@ -68,64 +249,192 @@ func (t *TerraformCloudClient) CheckWorkspace(workspace string) (string, error)
 	} else if err != nil {
 		return "", err
 	}
+	if ws.AutoApply != autoApply {
+		if err := t.setAutoApply(ws.ID, autoApply); err != nil {
+			return "", err
+		}
+	}
 	return ws.ID, err
 }
 
+// setAutoApply updates a workspace's auto-apply flag.
+func (t *TerraformCloudClient) setAutoApply(workspaceID string, autoApply bool) error {
+	_, err := t.Client.Workspaces.UpdateByID(context.TODO(), workspaceID, tfc.WorkspaceUpdateOptions{
+		AutoApply: &autoApply,
+	})
+	return err
+}
+
 func changeTypeToTFCVariable(specVariables []*v1alpha1.Variable) []*tfc.Variable {
 	tfcVariables := []*tfc.Variable{}
 	for _, variable := range specVariables {
 		tfcVariables = append(tfcVariables, &tfc.Variable{
 			Key:       variable.Key,
 			Value:     variable.Value,
+			Category:  toTFCCategory(variable.Category),
+			HCL:       variable.HCL,
 			Sensitive: variable.Sensitive,
 		})
 	}
 	return tfcVariables
 }
 
-// CheckVariables creates, updates, or deletes variables as needed
-func (t *TerraformCloudClient) CheckVariables(workspace string, specVariables []*v1alpha1.Variable) error {
-	specTFCVariables := changeTypeToTFCVariable(specVariables)
-	tfcWorkspace, err := t.Client.Workspaces.Read(context.TODO(), t.Organization, workspace)
-	if err != nil {
-		return err
+// toTFCCategory maps a v1alpha1.VariableCategory to its go-tfe equivalent, defaulting to a
+// Terraform variable.
+func toTFCCategory(category v1alpha1.VariableCategory) tfc.CategoryType {
+	if category == v1alpha1.CategoryEnv {
+		return EnvironmentVariable
 	}
-	workspaceVariables, err := t.listVariables(workspace)
+	return TerraformVariable
+}
+
+// hashValue returns a hex-encoded SHA-256 hash of value, used to detect drift in sensitive
+// variables without ever storing or comparing their plaintext.
+func hashValue(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckVariables creates, updates, or deletes variables on instance's workspace as needed.
+// Sensitive variables are compared by a hash annotation on instance rather than their value,
+// since Terraform Cloud never returns a sensitive value back once it has been set. The workspace
+// and its variable list are read through a short-lived cache, and mutations are issued
+// concurrently through a bounded worker pool, to keep reconciling workspaces with hundreds of
+// variables from hitting Terraform Cloud's rate limit.
+func (t *TerraformCloudClient) CheckVariables(instance *v1alpha1.Workspace) error {
+	workspace := instance.Name
+	specTFCVariables := changeTypeToTFCVariable(instance.Spec.Variables)
+
+	tfcWorkspace, workspaceVariables, err := t.workspaceAndVariables(workspace)
 	if err != nil {
 		return err
 	}
+
+	if instance.Annotations == nil {
+		instance.Annotations = map[string]string{}
+	}
+
+	var (
+		g       errgroup.Group
+		sem     = make(chan struct{}, maxConcurrentVariableOps)
+		mu      sync.Mutex
+		mutated bool
+	)
+	submit := func(op func() error) {
+		sem <- struct{}{}
+		mu.Lock()
+		mutated = true
+		mu.Unlock()
+		g.Go(func() error {
+			defer func() { <-sem }()
+			return op()
+		})
+	}
+
 	for _, v := range workspaceVariables {
-		index := find(specTFCVariables, v.Key)
-		if index < 0 {
-			err := t.DeleteVariable(v)
-			if err != nil {
-				return err
-			}
+		v := v
+		if find(specTFCVariables, v.Key, v.Category) >= 0 {
+			continue
 		}
+		submit(func() error {
+			tfcAPICalls.WithLabelValues("Variables.Delete").Inc()
+			return t.DeleteVariable(v)
+		})
 	}
+
 	for _, v := range specTFCVariables {
-		index := find(workspaceVariables, v.Key)
+		v := v
+		hashAnnotation := sensitiveHashAnnotation + string(v.Category) + "." + v.Key
+		index := find(workspaceVariables, v.Key, v.Category)
 		if index < 0 {
-			err := t.CreateTerraformVariable(tfcWorkspace, v.Key, v.Value)
-			if err != nil {
-				return err
-			}
+			submit(func() error {
+				tfcAPICalls.WithLabelValues("Variables.Create").Inc()
+				if err := t.createVariable(tfcWorkspace, v); err != nil {
+					return err
+				}
+				if v.Sensitive {
+					mu.Lock()
+					instance.Annotations[hashAnnotation] = hashValue(v.Value)
+					mu.Unlock()
+				}
+				return nil
+			})
 			continue
 		}
-		if v.Value != workspaceVariables[index].Value {
-			err := t.UpdateTerraformVariable(workspaceVariables[index], v.Value)
-			if err != nil {
-				return err
+
+		existing := workspaceVariables[index]
+		if v.Sensitive {
+			hash := hashValue(v.Value)
+			if instance.Annotations[hashAnnotation] == hash {
+				continue
 			}
+			submit(func() error {
+				tfcAPICalls.WithLabelValues("Variables.Update").Inc()
+				if err := t.updateVariable(existing, v); err != nil {
+					return err
+				}
+				mu.Lock()
+				instance.Annotations[hashAnnotation] = hash
+				mu.Unlock()
+				return nil
+			})
+			continue
+		}
+
+		if v.Value != existing.Value || v.HCL != existing.HCL {
+			submit(func() error {
+				tfcAPICalls.WithLabelValues("Variables.Update").Inc()
+				return t.updateVariable(existing, v)
+			})
 		}
 	}
-	return nil
+
+	err = g.Wait()
+
+	// Only invalidate the cache when a variable was actually created, updated, or deleted, so an
+	// unchanged reconcile keeps serving workspaceAndVariables from the cache instead of re-reading
+	// the workspace and its variables from Terraform Cloud on every call. This must happen even on
+	// a partial failure: some of the submitted mutations may have already succeeded against
+	// Terraform Cloud before g.Wait() returned an error, and serving the stale pre-mutation list
+	// until the cache entry's TTL expires would make the next reconcile retry those same mutations
+	// and collide with the ones that already landed.
+	if mutated {
+		globalWorkspaceCache.invalidate(t.Organization + "/" + workspace)
+	}
+	return err
+}
+
+// createVariable creates a Terraform Cloud variable from v, preserving its category, HCL flag,
+// and sensitivity.
+func (t *TerraformCloudClient) createVariable(workspace *tfc.Workspace, v *tfc.Variable) error {
+	options := tfc.VariableCreateOptions{
+		Key:       &v.Key,
+		Value:     &v.Value,
+		Category:  &v.Category,
+		HCL:       &v.HCL,
+		Sensitive: &v.Sensitive,
+		Workspace: workspace,
+	}
+	_, err := t.Client.Variables.Create(context.TODO(), options)
+	return err
 }
 
-// CreateWorkspace creates a Terraform Cloud Workspace that auto-applies
-func (t *TerraformCloudClient) CreateWorkspace(workspace string) (string, error) {
+// updateVariable updates a Terraform Cloud variable's value, HCL flag, and sensitivity.
+func (t *TerraformCloudClient) updateVariable(existing *tfc.Variable, v *tfc.Variable) error {
+	options := tfc.VariableUpdateOptions{
+		Key:       &v.Key,
+		Value:     &v.Value,
+		HCL:       &v.HCL,
+		Sensitive: &v.Sensitive,
+	}
+	_, err := t.Client.Variables.Update(context.TODO(), existing.ID, options)
+	return err
+}
+
+// CreateWorkspace creates a Terraform Cloud Workspace with the given auto-apply setting.
+func (t *TerraformCloudClient) CreateWorkspace(workspace string, autoApply bool) (string, error) {
 	options := tfc.WorkspaceCreateOptions{
-		AutoApply: &AutoApply,
+		AutoApply: &autoApply,
 		Name:      &workspace,
 	}
 	ws, err := t.Client.Workspaces.Create(context.TODO(), t.Organization, options)
@@ -144,84 +453,140 @@ func (t *TerraformCloudClient) DeleteWorkspace(workspace string) error {
 	return nil
 }
 
-func find(tfcVariables []*tfc.Variable, key string) int {
-	for index, variable := range tfcVariables {
-		if variable.Key == key {
-			return index
-		}
+// ApplyModule uploads the Workspace's configuration to Terraform Cloud according to its Source
+// and returns the new configuration version. It does not queue a run; CreateRun does. Source
+// defaults to Remote when unset.
+func (t *TerraformCloudClient) ApplyModule(workspaceID string, params v1alpha1.WorkspaceParameters) (*tfc.ConfigurationVersion, error) {
+	switch params.Source {
+	case v1alpha1.ModuleSourceInline:
+		return t.applyInlineModule(workspaceID, params.Module)
+	case v1alpha1.ModuleSourceRemote, "":
+		return t.applyRemoteModule(workspaceID, params.Module)
+	default:
+		return nil, fmt.Errorf("unknown module source %q", params.Source)
 	}
-	return -1
 }
 
-func (t *TerraformCloudClient) listVariables(workspace string) ([]*tfc.Variable, error) {
-	options := tfc.VariableListOptions{
-		ListOptions:  tfc.ListOptions{PageSize: PageSize},
-		Organization: &t.Organization,
-		Workspace:    &workspace,
-	}
-	variables, err := t.Client.Variables.List(context.TODO(), options)
+// applyInlineModule packages the literal HCL in module as a main.tf and uploads it as a new
+// configuration version.
+func (t *TerraformCloudClient) applyInlineModule(workspaceID, module string) (*tfc.ConfigurationVersion, error) {
+	dir, err := ioutil.TempDir("", "tfc-inline")
 	if err != nil {
 		return nil, err
 	}
-	return variables.Items, nil
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, mainConfigFile), []byte(module), 0644); err != nil {
+		return nil, err
+	}
+	return t.packAndUpload(workspaceID, dir)
 }
 
-// DeleteVariable removes the variable by ID from Terraform Cloud
-func (t *TerraformCloudClient) DeleteVariable(variable *tfc.Variable) error {
-	err := t.Client.Variables.Delete(context.TODO(), variable.ID)
+// applyRemoteModule fetches module with go-getter (a VCS repository, S3/GCS bucket, HTTP archive,
+// or any other go-getter source), then uploads the fetched configuration as a new configuration
+// version. Module registry addresses are not resolved; module must be a go-getter source.
+func (t *TerraformCloudClient) applyRemoteModule(workspaceID, module string) (*tfc.ConfigurationVersion, error) {
+	dir, err := ioutil.TempDir("", "tfc-remote")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	defer os.RemoveAll(dir)
+
+	if err := getter.Get(dir, module); err != nil {
+		return nil, fmt.Errorf("fetching module %q: %v", module, err)
+	}
+	return t.packAndUpload(workspaceID, dir)
 }
 
-// CreateTerraformVariables creates Terraform variables for Terraform Cloud
-func (t *TerraformCloudClient) CreateTerraformVariables(workspace string, variables []*v1alpha1.Variable) error {
-	tfcWorkspace, err := t.Client.Workspaces.Read(context.TODO(), t.Organization, workspace)
+// packAndUpload packages dir as a Terraform configuration tarball (via go-slug, through the TFC
+// client's upload helper) and uploads it to the workspace as a new configuration version.
+// AutoQueueRuns is disabled since CreateRun is the single intended entry point for triggering a
+// run, with the operator's targeting/replace/refresh-only options applied to it.
+func (t *TerraformCloudClient) packAndUpload(workspaceID, dir string) (*tfc.ConfigurationVersion, error) {
+	cv, err := t.Client.ConfigurationVersions.Create(context.TODO(), workspaceID, tfc.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfc.Bool(false),
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for _, variable := range variables {
-		options := tfc.VariableCreateOptions{
-			Key:       &variable.Key,
-			Value:     &variable.Value,
-			Category:  &TerraformVariable,
-			Sensitive: &variable.Sensitive,
-			Workspace: tfcWorkspace,
-		}
-		_, err := t.Client.Variables.Create(context.TODO(), options)
-		if err != nil {
-			return err
-		}
+	if err := t.Client.ConfigurationVersions.Upload(context.TODO(), cv.UploadURL, dir); err != nil {
+		return nil, err
 	}
-	return nil
+	return cv, nil
 }
 
-// UpdateTerraformVariable updates a variable
-func (t *TerraformCloudClient) UpdateTerraformVariable(variable *tfc.Variable, newValue string) error {
-	options := tfc.VariableUpdateOptions{
-		Key:   &variable.Key,
-		Value: &newValue,
+// CreateRun triggers a run against the given configuration version, applying any targeting,
+// replacement, refresh-only, or message options set on the Workspace. Targeting and replacement
+// are rejected with a clear error on Terraform Cloud instances whose remote API predates 2.3.
+func (t *TerraformCloudClient) CreateRun(workspaceID string, cv *tfc.ConfigurationVersion, options *v1alpha1.RunOptions) (*tfc.Run, error) {
+	if options == nil {
+		options = &v1alpha1.RunOptions{}
+	}
+
+	if len(options.Target) > 0 || len(options.Replace) > 0 {
+		if err := requireTargetingSupport(t.Client.RemoteAPIVersion()); err != nil {
+			return nil, err
+		}
+	}
+
+	runOptions := tfc.RunCreateOptions{
+		Workspace:            &tfc.Workspace{ID: workspaceID},
+		ConfigurationVersion: cv,
+		RefreshOnly:          &options.RefreshOnly,
+		TargetAddrs:          options.Target,
+		ReplaceAddrs:         options.Replace,
+	}
+	if options.Message != "" {
+		runOptions.Message = &options.Message
 	}
-	_, err := t.Client.Variables.Update(context.TODO(), variable.ID, options)
+
+	return t.Client.Runs.Create(context.TODO(), runOptions)
+}
+
+// requireTargetingSupport returns an error if remoteAPIVersion is older than
+// minTargetingAPIVersion, the lowest version that accepts -target and -replace run options.
+func requireTargetingSupport(remoteAPIVersion string) error {
+	remoteVersion, err := version.NewVersion(remoteAPIVersion)
 	if err != nil {
-		return err
+		return fmt.Errorf("parsing remote API version %q: %v", remoteAPIVersion, err)
+	}
+	if remoteVersion.LessThan(minTargetingAPIVersion) {
+		return fmt.Errorf("resource targeting requires Terraform Cloud remote API %s or newer, got %s", minTargetingAPIVersion, remoteVersion)
 	}
 	return nil
 }
 
-// CreateTerraformVariable creates a Terraform variable based on key and value
-func (t *TerraformCloudClient) CreateTerraformVariable(workspace *tfc.Workspace, key string, value string) error {
-	options := tfc.VariableCreateOptions{
-		Key:       &key,
-		Value:     &value,
-		Category:  &TerraformVariable,
-		Sensitive: &Sensitive,
-		Workspace: workspace,
+// find returns the index of the tfc.Variable with the given key and category in tfcVariables, or
+// -1. Variables are scoped by key+category, since Terraform and environment variables may share
+// the same key.
+func find(tfcVariables []*tfc.Variable, key string, category tfc.CategoryType) int {
+	for index, variable := range tfcVariables {
+		if variable.Key == key && variable.Category == category {
+			return index
+		}
 	}
-	_, err := t.Client.Variables.Create(context.TODO(), options)
+	return -1
+}
+
+func (t *TerraformCloudClient) listVariables(workspace string) ([]*tfc.Variable, error) {
+	options := tfc.VariableListOptions{
+		ListOptions:  tfc.ListOptions{PageSize: PageSize},
+		Organization: &t.Organization,
+		Workspace:    &workspace,
+	}
+	variables, err := t.Client.Variables.List(context.TODO(), options)
+	if err != nil {
+		return nil, err
+	}
+	return variables.Items, nil
+}
+
+// DeleteVariable removes the variable by ID from Terraform Cloud
+func (t *TerraformCloudClient) DeleteVariable(variable *tfc.Variable) error {
+	err := t.Client.Variables.Delete(context.TODO(), variable.ID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
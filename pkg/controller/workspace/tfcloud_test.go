@@ -0,0 +1,91 @@
+package workspace
+
+import (
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-k8s/pkg/apis/app/v1alpha1"
+)
+
+func TestHashValueIsDeterministicAndSensitiveToContent(t *testing.T) {
+	a := hashValue("supersecret")
+	b := hashValue("supersecret")
+	c := hashValue("different")
+	if a != b {
+		t.Fatalf("expected equal hashes for equal values, got %q and %q", a, b)
+	}
+	if a == c {
+		t.Fatalf("expected different hashes for different values")
+	}
+}
+
+func TestChangeTypeToTFCVariableDoesNotEscapeHCLValues(t *testing.T) {
+	specVariables := []*v1alpha1.Variable{
+		{Key: "list_var", Value: `["a\nb", "c\"d"]`, HCL: true},
+	}
+
+	got := changeTypeToTFCVariable(specVariables)
+	if got[0].Value != specVariables[0].Value {
+		t.Fatalf("expected the HCL value to pass through unescaped, got %q, want %q", got[0].Value, specVariables[0].Value)
+	}
+}
+
+func TestFindScopesByKeyAndCategory(t *testing.T) {
+	variables := []*tfc.Variable{
+		{Key: "foo", Category: tfc.CategoryTerraform},
+		{Key: "foo", Category: tfc.CategoryEnv},
+	}
+
+	if index := find(variables, "foo", tfc.CategoryEnv); index != 1 {
+		t.Fatalf("expected index 1, got %d", index)
+	}
+	if index := find(variables, "foo", tfc.CategoryTerraform); index != 0 {
+		t.Fatalf("expected index 0, got %d", index)
+	}
+	if index := find(variables, "missing", tfc.CategoryTerraform); index != -1 {
+		t.Fatalf("expected -1, got %d", index)
+	}
+}
+
+func TestToTFCCategoryDefaultsToTerraform(t *testing.T) {
+	if got := toTFCCategory(""); got != TerraformVariable {
+		t.Fatalf("expected default category to be terraform, got %v", got)
+	}
+	if got := toTFCCategory(v1alpha1.CategoryEnv); got != EnvironmentVariable {
+		t.Fatalf("expected env category, got %v", got)
+	}
+}
+
+func TestRequireTargetingSupport(t *testing.T) {
+	if err := requireTargetingSupport("2.3"); err != nil {
+		t.Fatalf("expected the minimum supported version to pass, got %v", err)
+	}
+	if err := requireTargetingSupport("2.4"); err != nil {
+		t.Fatalf("expected a newer version to pass, got %v", err)
+	}
+	if err := requireTargetingSupport("2.2"); err == nil {
+		t.Fatalf("expected an older version to be rejected")
+	}
+	if err := requireTargetingSupport("not-a-version"); err == nil {
+		t.Fatalf("expected an unparseable version to be rejected")
+	}
+}
+
+func TestAutoApplyFor(t *testing.T) {
+	cases := []struct {
+		name   string
+		params v1alpha1.WorkspaceParameters
+		want   bool
+	}{
+		{"no gating configured", v1alpha1.WorkspaceParameters{}, true},
+		{"cost estimate gating", v1alpha1.WorkspaceParameters{CostEstimateEnabled: true}, false},
+		{"policy check override", v1alpha1.WorkspaceParameters{PolicyCheckOverride: true}, false},
+		{"both gates", v1alpha1.WorkspaceParameters{CostEstimateEnabled: true, PolicyCheckOverride: true}, false},
+	}
+
+	for _, c := range cases {
+		if got := autoApplyFor(c.params); got != c.want {
+			t.Errorf("%s: autoApplyFor() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/hashicorp/terraform-k8s/pkg/apis/app/v1alpha1"
+	"github.com/hashicorp/terraform/command/cliconfig"
+)
+
+// defaultHostname is used when a Workspace does not set Hostname, matching Terraform Cloud's own
+// default.
+const defaultHostname = "app.terraform.io"
+
+// defaultSecretKey is the key read from a referenced Secret's data when TokenSecretRef.Key is
+// unset.
+const defaultSecretKey = "token"
+
+// TokenSource resolves the Terraform Cloud API token TerraformCloudClient authenticates with.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// CLIConfigTokenSource reads the token from the local Terraform CLI config (~/.terraformrc), the
+// same credentials block the Terraform CLI itself uses. It is unusable in-cluster unless that
+// file has been provisioned some other way.
+type CLIConfigTokenSource struct {
+	Hostname string
+}
+
+// Token implements TokenSource.
+func (s CLIConfigTokenSource) Token() (string, error) {
+	tfConfig, diag := cliconfig.LoadConfig()
+	if diag.Err() != nil {
+		return "", diag.Err()
+	}
+
+	hostname := s.Hostname
+	if hostname == "" {
+		hostname = defaultHostname
+	}
+
+	token, _ := tfConfig.Credentials[hostname]["token"].(string)
+	if token == "" {
+		return "", fmt.Errorf("no credentials for host %q in the Terraform CLI config", hostname)
+	}
+	return token, nil
+}
+
+// EnvTokenSource reads the token from the TFE_TOKEN or TFC_TOKEN environment variable, in that
+// order.
+type EnvTokenSource struct{}
+
+// Token implements TokenSource.
+func (EnvTokenSource) Token() (string, error) {
+	if token := os.Getenv("TFE_TOKEN"); token != "" {
+		return token, nil
+	}
+	if token := os.Getenv("TFC_TOKEN"); token != "" {
+		return token, nil
+	}
+	return "", fmt.Errorf("neither TFE_TOKEN nor TFC_TOKEN is set")
+}
+
+// SecretTokenSource reads the token from a key in a Kubernetes Secret, so the operator can run
+// in-cluster with no local Terraform CLI config.
+type SecretTokenSource struct {
+	Client    client.Client
+	Namespace string
+	Name      string
+	Key       string
+}
+
+// Token implements TokenSource.
+func (s SecretTokenSource) Token() (string, error) {
+	key := s.Key
+	if key == "" {
+		key = defaultSecretKey
+	}
+
+	secret := &corev1.Secret{}
+	namespacedName := types.NamespacedName{Namespace: s.Namespace, Name: s.Name}
+	if err := s.Client.Get(context.TODO(), namespacedName, secret); err != nil {
+		return "", err
+	}
+
+	token, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %q", namespacedName, key)
+	}
+	return string(token), nil
+}
+
+// NewTokenSource builds the TokenSource selected by params.TokenSource, defaulting to the local
+// Terraform CLI config when unset. namespace is used as the Secret's namespace when
+// params.TokenSecretRef does not set one.
+func NewTokenSource(c client.Client, namespace string, params v1alpha1.WorkspaceParameters) (TokenSource, error) {
+	switch params.TokenSource {
+	case v1alpha1.TokenSourceEnv:
+		return EnvTokenSource{}, nil
+	case v1alpha1.TokenSourceSecret:
+		if params.TokenSecretRef == nil {
+			return nil, fmt.Errorf("tokenSource Secret requires tokenSecretRef to be set")
+		}
+		secretNamespace := params.TokenSecretRef.Namespace
+		if secretNamespace == "" {
+			secretNamespace = namespace
+		}
+		return SecretTokenSource{
+			Client:    c,
+			Namespace: secretNamespace,
+			Name:      params.TokenSecretRef.Name,
+			Key:       params.TokenSecretRef.Key,
+		}, nil
+	case v1alpha1.TokenSourceCLIConfig, "":
+		return CLIConfigTokenSource{Hostname: params.Hostname}, nil
+	default:
+		return nil, fmt.Errorf("unknown token source %q", params.TokenSource)
+	}
+}
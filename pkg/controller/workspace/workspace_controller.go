@@ -0,0 +1,140 @@
+package workspace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-k8s/pkg/apis/app/v1alpha1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var log = logf.Log.WithName("controller_workspace")
+
+// coalesceWindow is how long Reconcile requeues a Workspace without doing work if it was already
+// reconciled within this window, so a burst of CR updates to the same workspace collapses into a
+// single Terraform Cloud sync.
+const coalesceWindow = 2 * time.Second
+
+var (
+	lastReconcileMu sync.Mutex
+	lastReconcileAt = map[string]time.Time{}
+)
+
+// coalesce returns the time to wait before the given request should be re-queued if it was
+// already reconciled within coalesceWindow, and true if it should be skipped for now.
+func coalesce(key string) (time.Duration, bool) {
+	lastReconcileMu.Lock()
+	defer lastReconcileMu.Unlock()
+
+	if last, ok := lastReconcileAt[key]; ok {
+		if elapsed := time.Since(last); elapsed < coalesceWindow {
+			return coalesceWindow - elapsed, true
+		}
+	}
+	lastReconcileAt[key] = time.Now()
+	return 0, false
+}
+
+// ReconcileWorkspace reconciles a Workspace object against Terraform Cloud.
+type ReconcileWorkspace struct {
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+var _ reconcile.Reconciler = &ReconcileWorkspace{}
+
+// Reconcile syncs a Workspace's variables, uploads its configuration, and triggers a run. It then
+// polls the run through plan, cost estimate, policy check, and apply, surfacing each stage on the
+// Workspace's status. This turns the CRD from a fire-and-forget variable sync into a GitOps-style
+// guardrail for Terraform Cloud runs.
+func (r *ReconcileWorkspace) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+
+	if wait, skip := coalesce(request.String()); skip {
+		reqLogger.V(1).Info("Coalescing reconcile", "RequeueAfter", wait)
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	start := time.Now()
+	defer func() {
+		reconcileDuration.WithLabelValues(request.Name).Observe(time.Since(start).Seconds())
+	}()
+
+	reqLogger.Info("Reconciling Workspace")
+
+	instance := &v1alpha1.Workspace{}
+	if err := r.client.Get(context.TODO(), request.NamespacedName, instance); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	tokenSource, err := NewTokenSource(r.client, instance.Namespace, instance.Spec)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	tfClient := &TerraformCloudClient{
+		Organization: instance.Spec.Organization,
+		Hostname:     instance.Spec.Hostname,
+		TokenSource:  tokenSource,
+	}
+	if err := tfClient.GetClient(); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	workspaceID, err := tfClient.CheckWorkspace(instance.Name, autoApplyFor(instance.Spec))
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	instance.Status.WorkspaceID = workspaceID
+
+	if err := tfClient.CheckVariables(instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Only upload the module and trigger a run when the spec has actually changed since the
+	// last one. Otherwise this status update below would retrigger a reconcile that uploads and
+	// runs again, forever, for an object whose spec never changed.
+	if specChanged(instance) {
+		cv, err := tfClient.ApplyModule(workspaceID, instance.Spec)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		run, err := tfClient.CreateRun(workspaceID, cv, instance.Spec.RunOptions)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		instance.Status.RunID = run.ID
+
+		stages, err := tfClient.PollRun(run.ID, instance.Spec)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		instance.Status.RunStatus = string(stages.Status)
+		instance.Status.CostEstimate = stages.CostEstimate
+		instance.Status.PolicyCheckStatus = stages.PolicyCheckStatus
+		instance.Status.ObservedGeneration = instance.Generation
+	} else {
+		reqLogger.V(1).Info("Spec unchanged since last run, skipping module upload and run")
+	}
+
+	if err := r.client.Update(context.TODO(), instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// specChanged reports whether instance's spec has changed since the last run was triggered for
+// it.
+func specChanged(instance *v1alpha1.Workspace) bool {
+	return instance.Generation != instance.Status.ObservedGeneration
+}
@@ -0,0 +1,41 @@
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-k8s/pkg/apis/app/v1alpha1"
+)
+
+func TestSpecChanged(t *testing.T) {
+	instance := &v1alpha1.Workspace{}
+	instance.Generation = 2
+	instance.Status.ObservedGeneration = 1
+	if !specChanged(instance) {
+		t.Fatalf("expected a mismatched generation to report changed")
+	}
+
+	instance.Status.ObservedGeneration = 2
+	if specChanged(instance) {
+		t.Fatalf("expected a matching generation to report unchanged")
+	}
+}
+
+func TestCoalesceSkipsWithinWindow(t *testing.T) {
+	key := "default/test-coalesce"
+
+	if _, skip := coalesce(key); skip {
+		t.Fatalf("expected the first reconcile for a key not to be coalesced")
+	}
+	if wait, skip := coalesce(key); !skip || wait <= 0 {
+		t.Fatalf("expected a reconcile within the window to be coalesced with a positive wait, got skip=%v wait=%v", skip, wait)
+	}
+
+	lastReconcileMu.Lock()
+	lastReconcileAt[key] = time.Now().Add(-coalesceWindow)
+	lastReconcileMu.Unlock()
+
+	if _, skip := coalesce(key); skip {
+		t.Fatalf("expected a reconcile after the window to proceed")
+	}
+}